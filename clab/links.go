@@ -0,0 +1,92 @@
+package clab
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/srl-labs/containerlab/links"
+	"github.com/srl-labs/containerlab/types"
+)
+
+// CreateLinks wires every link in c.Links by resolving its kind to a
+// links.Driver and attaching both endpoints as a pair, recording each
+// attachment so links.Reattach can replay it if a node's netns is recreated
+// after the link was made. postDeploy selects the second pass used for
+// links where either end belongs to a node kind (ceos) whose netns isn't
+// ready until after it restarts; such links are created after that restart,
+// so ceos' own post-deploy stop/start has none of them to replay. Up to
+// maxWorkers links are created concurrently.
+func (c *CLab) CreateLinks(ctx context.Context, maxWorkers uint, postDeploy bool) {
+	if maxWorkers == 0 {
+		maxWorkers = 1
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxWorkers)
+
+	for _, link := range c.Links {
+		if postDeploy != c.linkRequiresPostDeploy(link) {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(link types.LinkConfig) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := c.createLink(ctx, link); err != nil {
+				log.Errorf("failed to create link %s<->%s: %v",
+					link.Endpoints[0].IfName, link.Endpoints[1].IfName, err)
+			}
+		}(link)
+	}
+	wg.Wait()
+}
+
+// createLink resolves both ends of link and attaches them as a single pair
+// through their shared driver, then records the attachment for Reattach.
+func (c *CLab) createLink(ctx context.Context, link types.LinkConfig) error {
+	driver, ok := links.Get(link.Kind)
+	if !ok {
+		return fmt.Errorf("no links.Driver registered for kind %q", link.Kind)
+	}
+
+	a, err := c.linkEndpoint(link.Endpoints[0])
+	if err != nil {
+		return err
+	}
+	b, err := c.linkEndpoint(link.Endpoints[1])
+	if err != nil {
+		return err
+	}
+
+	opts := links.AttachOpts{Plugin: link.Plugin, Master: link.Master}
+	if err := driver.Attach(ctx, a, b, opts); err != nil {
+		return fmt.Errorf("%s<->%s: %v", link.Endpoints[0].Node, link.Endpoints[1].Node, err)
+	}
+	links.Record(a, b, driver, opts)
+	return nil
+}
+
+func (c *CLab) linkEndpoint(ep types.Endpoint) (links.Endpoint, error) {
+	node, ok := c.Nodes[ep.Node]
+	if !ok {
+		return links.Endpoint{}, fmt.Errorf("link references unknown node %q", ep.Node)
+	}
+	return links.Endpoint{NSPath: node.NSPath, IfName: ep.IfName}, nil
+}
+
+// linkRequiresPostDeploy reports whether link must be attached in the
+// post-deploy pass because either end belongs to a node kind that only has
+// a stable netns after its kind's post-deploy restart dance (currently just
+// ceos).
+func (c *CLab) linkRequiresPostDeploy(link types.LinkConfig) bool {
+	for _, ep := range link.Endpoints {
+		if node, ok := c.Nodes[ep.Node]; ok && node.Kind == "ceos" {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/srl-labs/containerlab/clab"
+	"github.com/srl-labs/containerlab/runtime"
 	"github.com/srl-labs/containerlab/types"
 	"github.com/srl-labs/containerlab/utils"
 )
@@ -24,6 +25,9 @@ var mgmtNetName string
 var mgmtIPv4Subnet net.IPNet
 var mgmtIPv6Subnet net.IPNet
 
+// IP stack mode for the management network: v4, v6 or dual
+var mgmtIPStack string
+
 // reconfigure flag
 var reconfigure bool
 
@@ -71,6 +75,9 @@ var deployCmd = &cobra.Command{
 			}
 			_ = destroyLab(ctx, c)
 			log.Infof("Removing %s directory...", c.Dir.Lab)
+			// a persistent overlay upperdir lives outside c.Dir.Lab by
+			// design (see utils.MountOverlayBind), so reconfiguring a node
+			// with `overlay: {upperdir: ...}` keeps its state across this
 			if err := os.RemoveAll(c.Dir.Lab); err != nil {
 				return err
 			}
@@ -84,6 +91,10 @@ var deployCmd = &cobra.Command{
 			return err
 		}
 
+		if err = checkIPv6Preflight(c.Config.Mgmt.IPStack); err != nil {
+			return err
+		}
+
 		log.Info("Creating lab directory: ", c.Dir.Lab)
 		utils.CreateDirectory(c.Dir.Lab, 0755)
 
@@ -95,8 +106,9 @@ var deployCmd = &cobra.Command{
 			return err
 		}
 
-		// create docker network or use existing one
-		if err = c.Runtime.CreateNet(ctx); err != nil {
+		// create the management network, or attach to an existing one if
+		// --network names a network the runtime already knows about
+		if err = createOrReuseMgmtNet(ctx, c); err != nil {
 			return err
 		}
 
@@ -140,6 +152,18 @@ var deployCmd = &cobra.Command{
 		log.Debug("enriching nodes with IP information...")
 		enrichNodes(containers, c.Nodes, c.Config.Mgmt.Network)
 
+		if err := attachSecondaryNetworks(ctx, c); err != nil {
+			return err
+		}
+
+		// re-list and re-enrich so secondary network addresses assigned
+		// above show up in /etc/hosts and the inventory/inspect output
+		containers, err = c.Runtime.ListContainers(ctx, labels)
+		if err != nil {
+			return fmt.Errorf("could not list containers: %v", err)
+		}
+		enrichNodes(containers, c.Nodes, c.Config.Mgmt.Network)
+
 		if err := c.GenerateInventories(); err != nil {
 			return err
 		}
@@ -182,6 +206,7 @@ func init() {
 	deployCmd.Flags().StringVarP(&mgmtNetName, "network", "", "", "management network name")
 	deployCmd.Flags().IPNetVarP(&mgmtIPv4Subnet, "ipv4-subnet", "4", net.IPNet{}, "management network IPv4 subnet range")
 	deployCmd.Flags().IPNetVarP(&mgmtIPv6Subnet, "ipv6-subnet", "6", net.IPNet{}, "management network IPv6 subnet range")
+	deployCmd.Flags().StringVarP(&mgmtIPStack, "ip-stack", "", "", "management network IP stack, one of: v4, v6, dual")
 	deployCmd.Flags().BoolVarP(&reconfigure, "reconfigure", "", false, "regenerate configuration artifacts and overwrite the previous ones if any")
 	deployCmd.Flags().UintVarP(&maxWorkers, "max-workers", "", 0, "limit the maximum number of workers creating nodes and virtual wires")
 }
@@ -199,6 +224,46 @@ func setFlags(conf *clab.Config) {
 	if mgmtIPv6Subnet.String() != "<nil>" {
 		conf.Mgmt.IPv6Subnet = mgmtIPv6Subnet.String()
 	}
+	if mgmtIPStack != "" {
+		conf.Mgmt.IPStack = mgmtIPStack
+	}
+}
+
+// checkIPv6Preflight validates that stack is one of the supported IPStack
+// values and, for v6/dual, that the host and docker daemon are actually
+// configured for IPv6 before attempting to stand up the management network,
+// since a half-enabled stack fails deep inside enrichNodes/createHostsFile
+// with confusing errors.
+func checkIPv6Preflight(stack string) error {
+	switch stack {
+	case "", types.IPStackV4:
+		return nil
+	case types.IPStackV6, types.IPStackDual:
+	default:
+		return fmt.Errorf("invalid --ip-stack %q, want one of %q, %q or %q",
+			stack, types.IPStackV4, types.IPStackV6, types.IPStackDual)
+	}
+	if err := utils.CheckIPv6Forwarding(); err != nil {
+		return err
+	}
+	return utils.CheckDockerIPv6Daemon()
+}
+
+// createOrReuseMgmtNet creates the lab's management network unless the
+// runtime supports runtime.NetworkManager and already has a network by that
+// name, in which case the existing network is reused so multiple labs can
+// share one bridge.
+func createOrReuseMgmtNet(ctx context.Context, c *clab.CLab) error {
+	if nm, ok := c.Runtime.(runtime.NetworkManager); ok && c.Config.Mgmt.Network != "" {
+		if _, err := nm.InspectNetwork(ctx, c.Config.Mgmt.Network); err == nil {
+			log.Infof("Reusing existing management network %q", c.Config.Mgmt.Network)
+			return nil
+		}
+	}
+	if ir, ok := c.Runtime.(runtime.IPStackAwareRuntime); ok && c.Config.Mgmt.IPStack != "" {
+		return ir.CreateNetWithIPStack(ctx, c.Config.Mgmt.IPStack)
+	}
+	return c.Runtime.CreateNet(ctx)
 }
 
 func createHostsFile(containers []types.GenericContainer, bridgeName string) error {
@@ -246,13 +311,22 @@ func hostsEntries(containers []types.GenericContainer, bridgeName string) []byte
 				buff.WriteString("\n")
 			}
 		}
+		name := strings.TrimLeft(cont.Names[0], "/")
+		for netName, ns := range cont.Networks {
+			if ns.IPv4addr != "" {
+				buff.WriteString(ns.IPv4addr + "\t" + name + "-" + netName + "\n")
+			}
+			if ns.IPv6addr != "" {
+				buff.WriteString(ns.IPv6addr + "\t" + name + "-" + netName + "\n")
+			}
+		}
 	}
 	return buff.Bytes()
 }
 
 func enrichNodes(containers []types.GenericContainer, nodes map[string]*types.NodeBase, mgmtNet string) {
 	for _, c := range containers {
-		name = c.Labels["clab-node-name"]
+		name := c.Labels["clab-node-name"]
 		if node, ok := nodes[name]; ok {
 			// add network information
 			// skipping host networking nodes as they don't have separate addresses
@@ -263,12 +337,54 @@ func enrichNodes(containers []types.GenericContainer, nodes map[string]*types.No
 			if c.NetworkSettings.Set {
 				node.MgmtIPv4Address = c.NetworkSettings.IPv4addr
 				node.MgmtIPv4PrefixLength = c.NetworkSettings.IPv4pLen
-				node.MgmtIPv6Address = c.NetworkSettings.IPv6addr
-				node.MgmtIPv6PrefixLength = c.NetworkSettings.IPv6pLen
+				// a statically assigned mgmt-ipv6 in the topology wins over
+				// whatever address docker handed out
+				if node.MgmtIPv6Address == "" {
+					node.MgmtIPv6Address = c.NetworkSettings.IPv6addr
+					node.MgmtIPv6PrefixLength = c.NetworkSettings.IPv6pLen
+				}
 			}
 
 			node.ContainerID = c.ID
+
+			// record the address the runtime assigned on each secondary
+			// network so /etc/hosts and the inspect table can render them
+			for i, att := range node.Networks {
+				if ns, ok := c.Networks[att.Name]; ok {
+					if att.IPv4 == "" {
+						node.Networks[i].IPv4 = ns.IPv4addr
+					}
+					if att.IPv6 == "" {
+						node.Networks[i].IPv6 = ns.IPv6addr
+					}
+				}
+			}
 		}
 
 	}
 }
+
+// attachSecondaryNetworks connects every node to the additional networks
+// declared in its `networks:` topology option, mirroring Multus's
+// default-plus-additional-networks model: the primary/mgmt network is
+// attached at container create time, secondaries are attached afterwards
+// via the runtime's NetworkManager.
+func attachSecondaryNetworks(ctx context.Context, c *clab.CLab) error {
+	nm, ok := c.Runtime.(runtime.NetworkManager)
+	for _, node := range c.Nodes {
+		if len(node.Networks) == 0 {
+			continue
+		}
+		if !ok {
+			return fmt.Errorf("runtime does not support secondary network attachments required by node %s", node.ShortName)
+		}
+		for _, att := range node.Networks {
+			log.Infof("Connecting node %s to network %s...", node.ShortName, att.Name)
+			opts := runtime.ConnectOpts{IPv4: att.IPv4, IPv6: att.IPv6, MAC: att.MAC, IfName: att.IfName}
+			if err := nm.ConnectContainer(ctx, att.Name, node.ContainerID, opts); err != nil {
+				return fmt.Errorf("failed to connect node %s to network %s: %v", node.ShortName, att.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/srl-labs/containerlab/types"
+)
+
+func TestHostsEntries(t *testing.T) {
+	containers := []types.GenericContainer{
+		{
+			Names: []string{"/clab-test-node1"},
+			NetworkSettings: types.NetworkSettings{
+				Set:      true,
+				IPv4addr: "172.20.20.2",
+				IPv6addr: "2001:db8::2",
+			},
+			Networks: map[string]types.NetworkSettings{
+				"clab-test-extra": {IPv4addr: "10.0.0.2"},
+			},
+		},
+		{
+			// no names: must be skipped, not crash
+			Names: nil,
+		},
+	}
+
+	got := string(hostsEntries(containers, "clab-mgmt"))
+
+	for _, want := range []string{
+		"172.20.20.2\tclab-test-node1\n",
+		"2001:db8::2\tclab-test-node1\n",
+		"10.0.0.2\tclab-test-node1-clab-test-extra\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("hostsEntries() = %q, want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestEnrichNodes(t *testing.T) {
+	nodes := map[string]*types.NodeBase{
+		"node1": {ShortName: "node1"},
+		"node2": {ShortName: "node2", NetworkMode: "host"},
+	}
+	containers := []types.GenericContainer{
+		{
+			ID:     "cid1",
+			Labels: map[string]string{"clab-node-name": "node1"},
+			NetworkSettings: types.NetworkSettings{
+				Set:      true,
+				IPv4addr: "172.20.20.3",
+				IPv4pLen: 24,
+				IPv6addr: "2001:db8::3",
+			},
+		},
+		{
+			ID:     "cid2",
+			Labels: map[string]string{"clab-node-name": "node2"},
+			NetworkSettings: types.NetworkSettings{
+				Set:      true,
+				IPv4addr: "172.20.20.4",
+			},
+		},
+	}
+
+	enrichNodes(containers, nodes, "clab-mgmt")
+
+	if nodes["node1"].ContainerID != "cid1" {
+		t.Errorf("node1.ContainerID = %q, want cid1", nodes["node1"].ContainerID)
+	}
+	if nodes["node1"].MgmtIPv4Address != "172.20.20.3" || nodes["node1"].MgmtIPv4PrefixLength != 24 {
+		t.Errorf("node1 mgmt IPv4 = %s/%d, want 172.20.20.3/24",
+			nodes["node1"].MgmtIPv4Address, nodes["node1"].MgmtIPv4PrefixLength)
+	}
+	if nodes["node1"].MgmtIPv6Address != "2001:db8::3" {
+		t.Errorf("node1.MgmtIPv6Address = %q, want 2001:db8::3", nodes["node1"].MgmtIPv6Address)
+	}
+
+	// host-networking nodes must be left untouched
+	if nodes["node2"].ContainerID != "" || nodes["node2"].MgmtIPv4Address != "" {
+		t.Errorf("node2 (NetworkMode: host) should not have been enriched, got %+v", nodes["node2"])
+	}
+}
+
+func TestEnrichNodesKeepsStaticMgmtIPv6(t *testing.T) {
+	nodes := map[string]*types.NodeBase{
+		"node1": {ShortName: "node1", MgmtIPv6Address: "2001:db8::static"},
+	}
+	containers := []types.GenericContainer{
+		{
+			ID:     "cid1",
+			Labels: map[string]string{"clab-node-name": "node1"},
+			NetworkSettings: types.NetworkSettings{
+				Set:      true,
+				IPv6addr: "2001:db8::dynamic",
+			},
+		},
+	}
+
+	enrichNodes(containers, nodes, "clab-mgmt")
+
+	if nodes["node1"].MgmtIPv6Address != "2001:db8::static" {
+		t.Errorf("MgmtIPv6Address = %q, want statically assigned address to win", nodes["node1"].MgmtIPv6Address)
+	}
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/srl-labs/containerlab/types"
+)
+
+// manifestFileName is the archive entry saveLab writes and restoreLab reads
+// to carry the per-node addressing that would otherwise be re-randomized by
+// a fresh ParseTopology (GenMac, secondary-network IP allocation, ...).
+const manifestFileName = "manifest.json"
+
+// saveManifest bundles the mgmt/secondary-network addressing assigned to
+// each node at save time, so restoreLab can re-inject it instead of letting
+// a fresh ParseTopology regenerate it.
+type saveManifest struct {
+	Nodes []nodeManifest `json:"nodes"`
+}
+
+type nodeManifest struct {
+	ShortName            string                    `json:"short_name"`
+	MacAddress           string                    `json:"mac_address"`
+	MgmtIPv4Address      string                    `json:"mgmt_ipv4_address,omitempty"`
+	MgmtIPv4PrefixLength int                       `json:"mgmt_ipv4_prefix_length,omitempty"`
+	MgmtIPv6Address      string                    `json:"mgmt_ipv6_address,omitempty"`
+	MgmtIPv6PrefixLength int                       `json:"mgmt_ipv6_prefix_length,omitempty"`
+	Networks             []types.NetworkAttachment `json:"networks,omitempty"`
+}
+
+// buildManifest captures the addressing saveLab must persist for every node
+// in nodes.
+func buildManifest(nodes map[string]*types.NodeBase) saveManifest {
+	m := saveManifest{Nodes: make([]nodeManifest, 0, len(nodes))}
+	for _, node := range nodes {
+		m.Nodes = append(m.Nodes, nodeManifest{
+			ShortName:            node.ShortName,
+			MacAddress:           node.MacAddress,
+			MgmtIPv4Address:      node.MgmtIPv4Address,
+			MgmtIPv4PrefixLength: node.MgmtIPv4PrefixLength,
+			MgmtIPv6Address:      node.MgmtIPv6Address,
+			MgmtIPv6PrefixLength: node.MgmtIPv6PrefixLength,
+			Networks:             node.Networks,
+		})
+	}
+	return m
+}
+
+func writeManifest(path string, m saveManifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+func readManifest(path string) (saveManifest, error) {
+	var m saveManifest
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+	err = json.Unmarshal(b, &m)
+	return m, err
+}
+
+// applyManifest re-injects the addressing recorded in m onto nodes, keyed by
+// ShortName, so a restored lab's GenMac-derived MAC and mgmt/secondary IPs
+// match what was actually checkpointed rather than whatever a fresh
+// ParseTopology would have assigned.
+func applyManifest(m saveManifest, nodes map[string]*types.NodeBase) {
+	for _, nm := range m.Nodes {
+		node, ok := nodes[nm.ShortName]
+		if !ok {
+			continue
+		}
+		node.MacAddress = nm.MacAddress
+		node.MgmtIPv4Address = nm.MgmtIPv4Address
+		node.MgmtIPv4PrefixLength = nm.MgmtIPv4PrefixLength
+		node.MgmtIPv6Address = nm.MgmtIPv6Address
+		node.MgmtIPv6PrefixLength = nm.MgmtIPv6PrefixLength
+		node.Networks = nm.Networks
+	}
+}
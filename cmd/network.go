@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/srl-labs/containerlab/clab"
+	"github.com/srl-labs/containerlab/runtime"
+)
+
+// networkDriverOpts holds the --opt key=value flags passed to `network create`.
+var networkDriverOpts []string
+
+// networkCmd represents the `network` command group, mirroring the
+// create/ls/inspect/rm/connect/disconnect lifecycle podman and nerdctl
+// expose for standalone management networks.
+var networkCmd = &cobra.Command{
+	Use:     "network",
+	Short:   "management network management",
+	Aliases: []string{"net"},
+}
+
+var networkCreateCmd = &cobra.Command{
+	Use:          "create <name>",
+	Short:        "create a management network",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nm, err := networkManager()
+		if err != nil {
+			return err
+		}
+		opts, err := parseNetworkOpts(networkDriverOpts)
+		if err != nil {
+			return err
+		}
+		id, err := nm.CreateNetwork(context.Background(), args[0], opts)
+		if err != nil {
+			return fmt.Errorf("failed to create network %s: %v", args[0], err)
+		}
+		fmt.Println(id)
+		return nil
+	},
+}
+
+var networkLsCmd = &cobra.Command{
+	Use:          "ls",
+	Short:        "list management networks",
+	Aliases:      []string{"list"},
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nm, err := networkManager()
+		if err != nil {
+			return err
+		}
+		nets, err := nm.ListNetworks(context.Background())
+		if err != nil {
+			return err
+		}
+		if format == "json" {
+			b, err := json.MarshalIndent(nets, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			return nil
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tID\tDRIVER")
+		for _, n := range nets {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", n.Name, n.ID, n.Driver)
+		}
+		return w.Flush()
+	},
+}
+
+var networkInspectCmd = &cobra.Command{
+	Use:          "inspect <name>",
+	Short:        "inspect a management network",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nm, err := networkManager()
+		if err != nil {
+			return err
+		}
+		n, err := nm.InspectNetwork(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+		b, err := json.MarshalIndent(n, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	},
+}
+
+var networkRmCmd = &cobra.Command{
+	Use:          "rm <name>",
+	Short:        "remove a management network",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nm, err := networkManager()
+		if err != nil {
+			return err
+		}
+		return nm.RemoveNetwork(context.Background(), args[0])
+	},
+}
+
+var (
+	networkConnectIPv4   string
+	networkConnectIPv6   string
+	networkConnectMAC    string
+	networkConnectIfName string
+)
+
+var networkConnectCmd = &cobra.Command{
+	Use:          "connect <name> <container>",
+	Short:        "connect a container to a management network",
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nm, err := networkManager()
+		if err != nil {
+			return err
+		}
+		opts := runtime.ConnectOpts{
+			IPv4:   networkConnectIPv4,
+			IPv6:   networkConnectIPv6,
+			MAC:    networkConnectMAC,
+			IfName: networkConnectIfName,
+		}
+		return nm.ConnectContainer(context.Background(), args[0], args[1], opts)
+	},
+}
+
+var networkDisconnectCmd = &cobra.Command{
+	Use:          "disconnect <name> <container>",
+	Short:        "disconnect a container from a management network",
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		nm, err := networkManager()
+		if err != nil {
+			return err
+		}
+		return nm.DisconnectContainer(context.Background(), args[0], args[1])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(networkCmd)
+	networkCmd.AddCommand(networkCreateCmd)
+	networkCmd.AddCommand(networkLsCmd)
+	networkCmd.AddCommand(networkInspectCmd)
+	networkCmd.AddCommand(networkRmCmd)
+	networkCmd.AddCommand(networkConnectCmd)
+	networkCmd.AddCommand(networkDisconnectCmd)
+
+	networkCreateCmd.Flags().StringArrayVarP(&networkDriverOpts, "opt", "o", []string{}, "driver-specific network option (key=value)")
+	networkLsCmd.Flags().StringVarP(&format, "format", "f", "table", "output format (table, json)")
+	networkConnectCmd.Flags().StringVarP(&networkConnectIPv4, "ipv4", "", "", "static IPv4 address to assign (CIDR form)")
+	networkConnectCmd.Flags().StringVarP(&networkConnectIPv6, "ipv6", "", "", "static IPv6 address to assign (CIDR form)")
+	networkConnectCmd.Flags().StringVarP(&networkConnectMAC, "mac", "", "", "static MAC address to assign")
+	networkConnectCmd.Flags().StringVarP(&networkConnectIfName, "ifname", "", "", "interface name inside the container")
+}
+
+// networkManager initializes the runtime configured via the global --runtime
+// flag and type-asserts it to runtime.NetworkManager, failing with a clear
+// error for runtimes that don't support standalone networks.
+func networkManager() (runtime.NetworkManager, error) {
+	c := clab.NewContainerLab(clab.WithRuntime(rt, debug, timeout, graceful))
+	nm, ok := c.Runtime.(runtime.NetworkManager)
+	if !ok {
+		return nil, fmt.Errorf("runtime %q does not support the network command", rt)
+	}
+	log.Debugf("using runtime %q for network management", rt)
+	return nm, nil
+}
+
+// parseNetworkOpts turns "key=value" flag entries into a map, the same
+// format CreateNetwork expects when forwarding driver options.
+func parseNetworkOpts(raw []string) (map[string]string, error) {
+	opts := make(map[string]string, len(raw))
+	for _, kv := range raw {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid --opt value %q, expected key=value", kv)
+		}
+		opts[parts[0]] = parts[1]
+	}
+	return opts, nil
+}
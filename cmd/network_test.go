@@ -0,0 +1,63 @@
+package cmd
+
+import "testing"
+
+func TestParseNetworkOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			raw:  nil,
+			want: map[string]string{},
+		},
+		{
+			name: "single",
+			raw:  []string{"com.docker.network.bridge.name=clab"},
+			want: map[string]string{"com.docker.network.bridge.name": "clab"},
+		},
+		{
+			name: "multiple",
+			raw:  []string{"a=1", "b=2"},
+			want: map[string]string{"a": "1", "b": "2"},
+		},
+		{
+			name:    "missing value",
+			raw:     []string{"a"},
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			raw:     []string{"=1"},
+			wantErr: true,
+		},
+		{
+			name: "value contains equals",
+			raw:  []string{"a=1=2"},
+			want: map[string]string{"a": "1=2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseNetworkOpts(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseNetworkOpts(%v) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseNetworkOpts(%v) = %v, want %v", tt.raw, got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Fatalf("parseNetworkOpts(%v) = %v, want %v", tt.raw, got, tt.want)
+				}
+			}
+		})
+	}
+}
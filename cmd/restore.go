@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/srl-labs/containerlab/clab"
+	"github.com/srl-labs/containerlab/runtime"
+)
+
+// restoreCmd represents the `restore` command
+var restoreCmd = &cobra.Command{
+	Use:          "restore <archive>",
+	Short:        "recreate a lab from an archive written by `containerlab save`",
+	Long:         "recreate the lab's management network with its saved IP stack, restore each node from its CRIU image with its saved mgmt/secondary-network IP and MAC assignments, and re-run link creation\nreference: https://containerlab.srlinux.dev/cmd/restore/",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return restoreLab(context.Background(), args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(restoreCmd)
+}
+
+// restoreLab unpacks archive (written by saveLab) into a scratch directory,
+// applies the saved manifest so each node's mgmt/secondary-network IP and
+// MAC match what was actually checkpointed (a fresh ParseTopology would
+// otherwise re-randomize GenMac and secondary-network allocation),
+// recreates the lab's management network with its saved IP stack, restores
+// each node from its CRIU image, and re-runs the link creation phase.
+func restoreLab(ctx context.Context, archive string) error {
+	tmpDir, err := os.MkdirTemp("", "clab-restore-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := unpackArchive(archive, tmpDir); err != nil {
+		return fmt.Errorf("failed to unpack %s: %v", archive, err)
+	}
+
+	topoFiles, err := filepath.Glob(filepath.Join(tmpDir, "*.clab.yml"))
+	if err != nil || len(topoFiles) == 0 {
+		return fmt.Errorf("archive %s does not contain a topology file", archive)
+	}
+	topo = topoFiles[0]
+
+	c := clab.NewContainerLab(
+		clab.WithDebug(debug),
+		clab.WithTimeout(timeout),
+		clab.WithTopoFile(topo),
+		clab.WithRuntime(rt, debug, timeout, graceful),
+	)
+	if err := c.ParseTopology(); err != nil {
+		return err
+	}
+
+	cr, ok := c.Runtime.(runtime.CheckpointRuntime)
+	if !ok {
+		return fmt.Errorf("runtime %q does not support checkpoint/restore", rt)
+	}
+
+	manifest, err := readManifest(filepath.Join(tmpDir, manifestFileName))
+	if err != nil {
+		return fmt.Errorf("archive %s does not contain a manifest: %v", archive, err)
+	}
+	applyManifest(manifest, c.Nodes)
+
+	log.Infof("Recreating management network %s (%s)...", c.Config.Mgmt.Network, c.Config.Mgmt.IPv4Subnet)
+	if err := createOrReuseMgmtNet(ctx, c); err != nil {
+		return err
+	}
+
+	for _, node := range c.Nodes {
+		criuDir := filepath.Join(tmpDir, "criu", node.ShortName)
+		log.Infof("Restoring node %s...", node.ShortName)
+		id, err := cr.RestoreContainer(ctx, criuDir)
+		if err != nil {
+			return fmt.Errorf("failed to restore node %s: %v", node.ShortName, err)
+		}
+		node.ContainerID = id
+	}
+
+	numLinks := uint(len(c.Links))
+	c.CreateLinks(ctx, numLinks, false)
+	c.CreateLinks(ctx, numLinks, true)
+
+	log.Infof("Lab %s restored from %s", c.Config.Name, archive)
+	return nil
+}
+
+// unpackArchive extracts a zstd/gzip/uncompressed tar written by saveLab
+// into dir, restoring the topology file, labdir and criu images relative to
+// it (e.g. "labdir/flash/startup-config" -> "<dir>/labdir/flash/startup-config").
+func unpackArchive(archive, dir string) error {
+	f, err := os.Open(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := decompressReader(f, archive)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, hdr.Name)
+		if !strings.HasPrefix(filepath.Clean(dst), filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("archive entry %q escapes extraction directory", hdr.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode))
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(out, tr)
+		out.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// decompressReader picks the decompressor based on archive's extension,
+// matching the --compress choices saveLab writes.
+func decompressReader(f *os.File, archive string) (io.Reader, error) {
+	switch {
+	case strings.HasSuffix(archive, ".zst"):
+		return zstd.NewReader(f)
+	case strings.HasSuffix(archive, ".gz"):
+		return gzip.NewReader(f)
+	default:
+		return f, nil
+	}
+}
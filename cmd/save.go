@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/srl-labs/containerlab/clab"
+	"github.com/srl-labs/containerlab/runtime"
+	"github.com/srl-labs/containerlab/utils"
+)
+
+// saveOut is the path of the archive written by `containerlab save`.
+var saveOut string
+
+// saveCompress selects the archive's compression: zstd (default), gzip or none.
+var saveCompress string
+
+// saveCmd represents the `save` command
+var saveCmd = &cobra.Command{
+	Use:          "save",
+	Short:        "checkpoint a running lab to a portable archive",
+	Long:         "checkpoint every node in a lab (via the runtime's CRIU-backed checkpoint API) and bundle the images, topology file, flash/config dirs and a manifest of mgmt/secondary-network IP and MAC assignments into a single archive\nreference: https://containerlab.srlinux.dev/cmd/save/",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	PreRunE:      sudoCheck,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := topoSet(); err != nil {
+			return err
+		}
+		c := clab.NewContainerLab(
+			clab.WithDebug(debug),
+			clab.WithTimeout(timeout),
+			clab.WithTopoFile(topo),
+			clab.WithRuntime(rt, debug, timeout, graceful),
+		)
+		if err := c.ParseTopology(); err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		// pick up the container IDs and the addresses the runtime actually
+		// assigned, so the checkpoint and manifest reflect the running lab
+		containers, err := c.Runtime.ListContainers(ctx, []string{"containerlab=" + c.Config.Name})
+		if err != nil {
+			return fmt.Errorf("could not list containers: %v", err)
+		}
+		enrichNodes(containers, c.Nodes, c.Config.Mgmt.Network)
+
+		if saveOut == "" {
+			// extension must match what decompressReader expects on restore
+			switch saveCompress {
+			case "gzip":
+				saveOut = args[0] + ".tar.gz"
+			case "none":
+				saveOut = args[0] + ".tar"
+			default:
+				saveOut = args[0] + ".tar.zst"
+			}
+		}
+
+		return saveLab(ctx, c, saveOut, saveCompress)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(saveCmd)
+	saveCmd.Flags().StringVarP(&saveOut, "out", "o", "", "archive to write (default <lab>.tar.zst, .tar.gz or .tar per --compress)")
+	saveCmd.Flags().StringVarP(&saveCompress, "compress", "", "zstd", "archive compression: zstd, gzip or none")
+}
+
+// saveLab checkpoints every node in c via the runtime's checkpoint API and
+// bundles the resulting CRIU images, the topology file, each node's
+// LabDir (flash/config, including cEOS startup-config and
+// system_mac_address) and a manifest of each node's mgmt/secondary-network
+// IP and MAC assignments into one archive.
+func saveLab(ctx context.Context, c *clab.CLab, out, compress string) error {
+	cr, ok := c.Runtime.(runtime.CheckpointRuntime)
+	if !ok {
+		return fmt.Errorf("runtime %q does not support checkpoint/restore", rt)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "clab-save-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for _, node := range c.Nodes {
+		dst := filepath.Join(tmpDir, "criu", node.ShortName)
+		utils.CreateDirectory(dst, 0755)
+		log.Infof("Checkpointing node %s...", node.ShortName)
+		if err := cr.CheckpointContainer(ctx, node.ContainerID, dst); err != nil {
+			return fmt.Errorf("failed to checkpoint node %s: %v", node.ShortName, err)
+		}
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w, closeW, err := compressWriter(f, compress)
+	if err != nil {
+		return err
+	}
+	defer closeW()
+
+	tw := tar.NewWriter(w)
+	defer tw.Close()
+
+	manifestPath := filepath.Join(tmpDir, manifestFileName)
+	if err := writeManifest(manifestPath, buildManifest(c.Nodes)); err != nil {
+		return err
+	}
+
+	if err := addFileToTar(tw, topo, filepath.Base(topo)); err != nil {
+		return err
+	}
+	if err := addFileToTar(tw, manifestPath, manifestFileName); err != nil {
+		return err
+	}
+	if err := addDirToTar(tw, c.Dir.Lab, "labdir"); err != nil {
+		return err
+	}
+	if err := addDirToTar(tw, filepath.Join(tmpDir, "criu"), "criu"); err != nil {
+		return err
+	}
+
+	log.Infof("Lab %s saved to %s", c.Config.Name, out)
+	return nil
+}
+
+// compressWriter wraps w according to the --compress flag, returning a
+// close func the caller must run before w itself is closed.
+func compressWriter(w io.Writer, compress string) (io.Writer, func() error, error) {
+	switch compress {
+	case "zstd", "":
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, nil, err
+		}
+		return zw, zw.Close, nil
+	case "gzip":
+		gw := gzip.NewWriter(w)
+		return gw, gw.Close, nil
+	case "none":
+		return w, func() error { return nil }, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown --compress value %q, want zstd, gzip or none", compress)
+	}
+}
+
+func addFileToTar(tw *tar.Writer, src, name string) error {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	hdr, err := tar.FileInfoHeader(fi, "")
+	if err != nil {
+		return err
+	}
+	hdr.Name = name
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+func addDirToTar(tw *tar.Writer, dir, prefix string) error {
+	return filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil || fi.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		return addFileToTar(tw, path, filepath.Join(prefix, rel))
+	})
+}
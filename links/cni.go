@@ -0,0 +1,111 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/containernetworking/cni/libcni"
+)
+
+// CNIKind is the link `kind` value that routes through the cni driver.
+const CNIKind = "cni"
+
+// defaultCNIBinDir is where CNI plugin binaries normally live on a
+// containerlab host; cniDriver looks plugins up here and builds network
+// config from the per-link topology fields, it never writes to this path.
+const defaultCNIBinDir = "/opt/cni/bin"
+
+func init() {
+	Register(&cniDriver{
+		cniConfig: libcni.NewCNIConfig([]string{defaultCNIBinDir}, nil),
+	})
+}
+
+// cniDriver attaches link endpoints by invoking CNI plugins (bridge,
+// macvlan, ptp, ovs, ...) against each node's netns instead of hand-rolling
+// netlink. Unlike veth, a CNI "link" isn't a pair: each endpoint
+// independently joins the same L2 segment (e.g. both nodes get a macvlan
+// interface riding the same master), so Attach/Detach just loop both sides.
+type cniDriver struct {
+	cniConfig *libcni.CNIConfig
+}
+
+func (d *cniDriver) Name() string { return CNIKind }
+
+func (d *cniDriver) Attach(ctx context.Context, a, b Endpoint, opts AttachOpts) error {
+	if opts.Plugin == "" {
+		return fmt.Errorf("cni link requires a plugin, e.g. bridge, macvlan, ptp, ovs")
+	}
+	for _, ep := range [2]Endpoint{a, b} {
+		if err := d.attachOne(ctx, ep, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *cniDriver) Detach(ctx context.Context, a, b Endpoint, opts AttachOpts) error {
+	for _, ep := range [2]Endpoint{a, b} {
+		if err := d.detachOne(ctx, ep, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *cniDriver) attachOne(ctx context.Context, ep Endpoint, opts AttachOpts) error {
+	netConf, err := d.networkConf(ep.IfName, opts)
+	if err != nil {
+		return err
+	}
+	rt := &libcni.RuntimeConf{
+		ContainerID: filepath.Base(ep.NSPath),
+		NetNS:       ep.NSPath,
+		IfName:      ep.IfName,
+	}
+	if _, err := d.cniConfig.AddNetwork(ctx, netConf, rt); err != nil {
+		return fmt.Errorf("cni plugin %q failed to attach %s: %v", opts.Plugin, ep.IfName, err)
+	}
+	return nil
+}
+
+func (d *cniDriver) detachOne(ctx context.Context, ep Endpoint, opts AttachOpts) error {
+	netConf, err := d.networkConf(ep.IfName, opts)
+	if err != nil {
+		return err
+	}
+	rt := &libcni.RuntimeConf{
+		ContainerID: filepath.Base(ep.NSPath),
+		NetNS:       ep.NSPath,
+		IfName:      ep.IfName,
+	}
+	return d.cniConfig.DelNetwork(ctx, netConf, rt)
+}
+
+// networkConf builds the libcni NetworkConfig for a single endpoint,
+// folding in the plugin/master fields declared on the link in the
+// topology YAML.
+func (d *cniDriver) networkConf(ifName string, opts AttachOpts) (*libcni.NetworkConfig, error) {
+	conf := map[string]interface{}{
+		"cniVersion": "0.4.0",
+		"name":       "clab-" + ifName,
+		"type":       opts.Plugin,
+	}
+	if opts.Master != "" {
+		conf["master"] = opts.Master
+	}
+	for k, v := range opts.Extra {
+		conf[k] = v
+	}
+	b, err := json.Marshal(conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cni config for plugin %q: %v", opts.Plugin, err)
+	}
+	netConf, err := libcni.ConfFromBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cni config for plugin %q: %v", opts.Plugin, err)
+	}
+	return netConf, nil
+}
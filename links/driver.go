@@ -0,0 +1,61 @@
+// Package links implements the datapath drivers containerlab uses to wire
+// nodes together: the default veth driver, and pluggable CNI-backed drivers
+// for users who want macvlan/ptp/ovs segments instead of raw veth pairs.
+package links
+
+import "context"
+
+// Endpoint identifies one side of a link: the path to the node's network
+// namespace and the interface name inside it.
+type Endpoint struct {
+	NSPath string
+	IfName string
+}
+
+// AttachOpts carries the per-link parameters a Driver needs to wire up a
+// link, as declared on the link in the topology YAML.
+type AttachOpts struct {
+	// Plugin is the CNI plugin name (e.g. "bridge", "macvlan", "ptp", "ovs").
+	// Ignored by the veth driver.
+	Plugin string
+	// Master is the host interface a macvlan/ipvlan attachment rides on.
+	Master string
+	// Extra are additional, driver-specific CNI network-config fields.
+	Extra map[string]interface{}
+}
+
+// Driver attaches and detaches both sides of a link at once. A link is
+// always a pair of endpoints, even for CNI kinds (where each endpoint
+// independently joins the same L2 segment) — modelling it any other way
+// left the veth driver creating two disconnected pairs instead of one.
+// Implementations must be safe to call concurrently for different links.
+type Driver interface {
+	// Name identifies the driver as used in the topology's `kind:` field.
+	Name() string
+	// Attach wires a up to b per opts, returning once both ends are up.
+	Attach(ctx context.Context, a, b Endpoint, opts AttachOpts) error
+	// Detach removes both ends created by Attach, releasing any CNI-held state.
+	Detach(ctx context.Context, a, b Endpoint, opts AttachOpts) error
+}
+
+// registry of known drivers, keyed by the `kind` field on a link.
+var registry = map[string]Driver{}
+
+// Register adds a driver to the registry under its Name(). Drivers call
+// this from an init() func, mirroring nodes.Register.
+func Register(d Driver) {
+	registry[d.Name()] = d
+}
+
+// Default is the driver used for links that don't set `kind`.
+const Default = "veth"
+
+// Get returns the driver registered for kind, falling back to the default
+// veth driver when kind is empty.
+func Get(kind string) (Driver, bool) {
+	if kind == "" {
+		kind = Default
+	}
+	d, ok := registry[kind]
+	return d, ok
+}
@@ -0,0 +1,60 @@
+package links
+
+import (
+	"context"
+	"sync"
+)
+
+// attachment is one link CreateLinks wired up, remembered so Reattach can
+// redo it if a participating node's netns is recreated (e.g. ceos' post-
+// deploy stop/start dance).
+type attachment struct {
+	a, b   Endpoint
+	driver Driver
+	opts   AttachOpts
+}
+
+// registryMu guards byNode, which is populated by CreateLinks as it
+// attaches each link, and replayed by Reattach when a node's netns changes.
+var registryMu sync.Mutex
+var byNode = map[string][]*attachment{}
+
+// Record remembers that a link between a and b was attached via d/opts,
+// indexing it under both endpoints' NSPath so Reattach can find it from
+// either side.
+func Record(a, b Endpoint, d Driver, opts AttachOpts) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	at := &attachment{a: a, b: b, driver: d, opts: opts}
+	byNode[a.NSPath] = append(byNode[a.NSPath], at)
+	byNode[b.NSPath] = append(byNode[b.NSPath], at)
+}
+
+// Reattach replays every recorded link touching oldNS against newNS, the
+// node's current (just-recreated) network namespace path, then re-indexes
+// those links under newNS so a later restart can find them again.
+// veth links are cheap to replay; CNI links are where this matters, since
+// their interfaces don't survive a container restart on their own.
+func Reattach(ctx context.Context, oldNS, newNS string) error {
+	registryMu.Lock()
+	ats := append([]*attachment(nil), byNode[oldNS]...)
+	registryMu.Unlock()
+
+	for _, at := range ats {
+		if at.a.NSPath == oldNS {
+			at.a.NSPath = newNS
+		}
+		if at.b.NSPath == oldNS {
+			at.b.NSPath = newNS
+		}
+		if err := at.driver.Attach(ctx, at.a, at.b, at.opts); err != nil {
+			return err
+		}
+	}
+
+	registryMu.Lock()
+	delete(byNode, oldNS)
+	byNode[newNS] = append(byNode[newNS], ats...)
+	registryMu.Unlock()
+	return nil
+}
@@ -0,0 +1,27 @@
+package links
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/srl-labs/containerlab/utils"
+)
+
+func init() {
+	Register(&vethDriver{})
+}
+
+// vethDriver is the original containerlab datapath: a single veth pair
+// with each end moved into its node's netns. It is kept as the default so
+// existing topologies need no changes to keep working.
+type vethDriver struct{}
+
+func (d *vethDriver) Name() string { return Default }
+
+func (d *vethDriver) Attach(ctx context.Context, a, b Endpoint, opts AttachOpts) error {
+	return utils.CreateVethPair(a.NSPath, a.IfName, b.NSPath, b.IfName)
+}
+
+func (d *vethDriver) Detach(ctx context.Context, a, b Endpoint, opts AttachOpts) error {
+	return fmt.Errorf("detach is not supported for the veth driver; destroy the node instead")
+}
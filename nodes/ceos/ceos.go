@@ -7,7 +7,6 @@ package ceos
 import (
 	"context"
 	_ "embed"
-	"fmt"
 	"net"
 	"path"
 	"path/filepath"
@@ -15,6 +14,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/srl-labs/containerlab/links"
 	"github.com/srl-labs/containerlab/nodes"
 	"github.com/srl-labs/containerlab/runtime"
 	"github.com/srl-labs/containerlab/types"
@@ -63,9 +63,19 @@ func (s *ceos) Init(cfg *types.NodeConfig, opts ...nodes.NodeOption) error {
 	s.cfg.Cmd = envSb.String()
 	s.cfg.MacAddress = utils.GenMac("00:1c:73")
 
-	// mount config dir
+	// mount config dir, as a plain bind or, when `overlay:` is set in the
+	// topology, as an overlayfs so a running cEOS can't contaminate the
+	// source-of-truth flash dir
 	cfgPath := filepath.Join(s.cfg.LabDir, "flash")
-	s.cfg.Binds = append(s.cfg.Binds, fmt.Sprintf("%s:/mnt/flash/", cfgPath))
+	bind, err := utils.MountOverlayBind(s.cfg.LabDir, types.Mount{
+		Source:      cfgPath,
+		Destination: "/mnt/flash",
+		Overlay:     s.cfg.Overlay,
+	})
+	if err != nil {
+		return err
+	}
+	s.cfg.Binds = append(s.cfg.Binds, bind)
 	return nil
 }
 
@@ -112,6 +122,7 @@ func ceosPostDeploy(ctx context.Context, r runtime.ContainerRuntime, nodeCfg *ty
 		return err
 	}
 	log.Infof("Restarting '%s' node", nodeCfg.ShortName)
+	oldNSPath := nodeCfg.NSPath
 	// force stopping and start is faster than ContainerRestart
 	var timeout time.Duration = 1
 	err = r.StopContainer(ctx, nodeCfg.ContainerID, &timeout)
@@ -131,5 +142,11 @@ func ceosPostDeploy(ctx context.Context, r runtime.ContainerRuntime, nodeCfg *ty
 	if err != nil {
 		return err
 	}
-	return utils.LinkContainerNS(nodeCfg.NSPath, nodeCfg.LongName)
+	if err := utils.LinkContainerNS(nodeCfg.NSPath, nodeCfg.LongName); err != nil {
+		return err
+	}
+	// restart gave the container a fresh netns; replay any links recorded
+	// against the old one (CNI-provisioned interfaces don't survive on their
+	// own; veth links need their host-side end rebuilt against the new ns)
+	return links.Reattach(ctx, oldNSPath, nodeCfg.NSPath)
 }
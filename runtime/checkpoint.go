@@ -0,0 +1,15 @@
+package runtime
+
+import "context"
+
+// CheckpointRuntime is implemented by runtimes that can checkpoint and
+// restore a running container's process state (Docker's CRIU-backed
+// ContainerCheckpoint API). Runtimes that don't support it leave
+// `containerlab save`/`restore` unavailable; callers should type-assert
+// ContainerRuntime to CheckpointRuntime before using it.
+type CheckpointRuntime interface {
+	// CheckpointContainer dumps containerID's CRIU images into dstDir.
+	CheckpointContainer(ctx context.Context, containerID, dstDir string) error
+	// RestoreContainer recreates a container from the CRIU images in srcDir.
+	RestoreContainer(ctx context.Context, srcDir string) (string, error)
+}
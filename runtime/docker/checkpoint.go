@@ -0,0 +1,34 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types"
+	"github.com/srl-labs/containerlab/runtime"
+)
+
+var _ runtime.CheckpointRuntime = (*DockerRuntime)(nil)
+
+// CheckpointContainer dumps containerID's CRIU images into dstDir via
+// docker's experimental checkpoint API.
+func (d *DockerRuntime) CheckpointContainer(ctx context.Context, containerID, dstDir string) error {
+	err := d.Client.CheckpointCreate(ctx, containerID, types.CheckpointCreateOptions{
+		CheckpointID:  "clab-save",
+		CheckpointDir: dstDir,
+		Exit:          true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to checkpoint container %s: %v", containerID, err)
+	}
+	return nil
+}
+
+// RestoreContainer is not supported by the docker runtime: it always
+// returns an error and restores nothing. Docker has no direct "restore"
+// call, only starting the container that was checkpointed, and srcDir
+// alone does not identify that container.
+func (d *DockerRuntime) RestoreContainer(ctx context.Context, srcDir string) (string, error) {
+	return "", fmt.Errorf("docker restore from a standalone checkpoint dir (%s) is not supported; "+
+		"the container that was checkpointed must still exist to be restarted from it", srcDir)
+}
@@ -0,0 +1,41 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/srl-labs/containerlab/runtime"
+)
+
+var _ runtime.IPStackAwareRuntime = (*DockerRuntime)(nil)
+
+// CreateNetWithIPStack creates the management network the same way CreateNet
+// does, but sets EnableIPv6 (and, via the ip6tables daemon option already
+// configured on the host per utils.CheckDockerIPv6Daemon, the accompanying
+// forwarding rules) for ipStack "v6" and "dual".
+func (d *DockerRuntime) CreateNetWithIPStack(ctx context.Context, ipStack string) error {
+	enableIPv6 := ipStack == "v6" || ipStack == "dual"
+
+	var ipam *network.IPAM
+	if d.Mgmt.IPv4Subnet != "" || d.Mgmt.IPv6Subnet != "" {
+		ipam = &network.IPAM{}
+		if d.Mgmt.IPv4Subnet != "" && ipStack != "v6" {
+			ipam.Config = append(ipam.Config, network.IPAMConfig{Subnet: d.Mgmt.IPv4Subnet})
+		}
+		if d.Mgmt.IPv6Subnet != "" && enableIPv6 {
+			ipam.Config = append(ipam.Config, network.IPAMConfig{Subnet: d.Mgmt.IPv6Subnet})
+		}
+	}
+
+	_, err := d.Client.NetworkCreate(ctx, d.Mgmt.Network, types.NetworkCreate{
+		Driver:     "bridge",
+		EnableIPv6: enableIPv6,
+		IPAM:       ipam,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create %s-stack management network %s: %v", ipStack, d.Mgmt.Network, err)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package runtime
+
+import "context"
+
+// IPStackAwareRuntime is implemented by runtimes that can push
+// enable_ipv6/ip6tables into the management network create request, so an
+// IPv6-only or dual-stack lab actually gets an IPv6-capable bridge instead
+// of silently falling back to v4. Runtimes that don't implement it only
+// support v4 management networks; callers should type-assert
+// ContainerRuntime to IPStackAwareRuntime before using it.
+type IPStackAwareRuntime interface {
+	// CreateNetWithIPStack creates the management network the same way
+	// CreateNet does, but with enable_ipv6 (and ip6tables, where the
+	// runtime supports it) set according to ipStack ("v4", "v6" or "dual").
+	CreateNetWithIPStack(ctx context.Context, ipStack string) error
+}
@@ -0,0 +1,58 @@
+package runtime
+
+import "context"
+
+// NetworkIPAM describes the IPAM configuration of a management network.
+type NetworkIPAM struct {
+	Subnet  string `json:"subnet,omitempty"`
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// NetworkInspect is the driver-neutral representation of a management
+// network returned by InspectNetwork.
+type NetworkInspect struct {
+	Name       string        `json:"name"`
+	ID         string        `json:"id"`
+	Driver     string        `json:"driver"`
+	IPAMv4     []NetworkIPAM `json:"ipam_v4,omitempty"`
+	IPAMv6     []NetworkIPAM `json:"ipam_v6,omitempty"`
+	Containers []string      `json:"containers,omitempty"`
+}
+
+// ConnectOpts carries the per-attachment parameters ConnectContainer should
+// honor instead of leaving them to the network's dynamic IPAM/naming
+// defaults.
+type ConnectOpts struct {
+	// IPv4/IPv6 are static addresses in CIDR form, e.g. "192.0.2.5/24".
+	// Empty means let the network's IPAM pool assign one.
+	IPv4 string
+	IPv6 string
+	// MAC is a static MAC address for this attachment. Empty means let the
+	// runtime generate one.
+	MAC string
+	// IfName is the interface name inside the container. Empty means the
+	// runtime's default naming (e.g. eth1, eth2, ...).
+	IfName string
+}
+
+// NetworkManager is implemented by runtimes that support standalone
+// management networks that outlive a single lab, mirroring the
+// create/ls/inspect/rm/connect/disconnect lifecycle exposed by podman and
+// nerdctl. Runtimes that embed network creation in CreateNet only are not
+// required to implement it; callers should type-assert ContainerRuntime to
+// NetworkManager before using it.
+type NetworkManager interface {
+	// CreateNetwork creates a named management network and returns its ID.
+	CreateNetwork(ctx context.Context, name string, opts map[string]string) (string, error)
+	// ListNetworks returns all networks known to the runtime, labelled or not.
+	ListNetworks(ctx context.Context) ([]NetworkInspect, error)
+	// InspectNetwork returns IPAM, gateway and attached-container details for a single network.
+	InspectNetwork(ctx context.Context, name string) (*NetworkInspect, error)
+	// RemoveNetwork removes a network by name. It must fail if containers are still attached.
+	RemoveNetwork(ctx context.Context, name string) error
+	// ConnectContainer attaches an already-running container to name,
+	// honoring any static IPv4/IPv6/MAC/IfName set in opts.
+	ConnectContainer(ctx context.Context, name, containerID string, opts ConnectOpts) error
+	// DisconnectContainer detaches containerID from name.
+	DisconnectContainer(ctx context.Context, name, containerID string) error
+}
@@ -0,0 +1,24 @@
+package types
+
+// NetworkSettings is the subset of a container's network info containerlab
+// cares about: whether the runtime populated it yet, and its addresses.
+type NetworkSettings struct {
+	Set      bool
+	IPv4addr string
+	IPv4pLen int
+	IPv6addr string
+	IPv6pLen int
+}
+
+// GenericContainer is the runtime-neutral container representation
+// returned by ContainerRuntime.ListContainers.
+type GenericContainer struct {
+	ID              string
+	Names           []string
+	Labels          map[string]string
+	NetworkSettings NetworkSettings
+	// Networks carries the same address info as NetworkSettings for every
+	// secondary network (types.NetworkAttachment) this container is
+	// connected to, keyed by network name.
+	Networks map[string]NetworkSettings
+}
@@ -0,0 +1,21 @@
+package types
+
+// Endpoint identifies one side of a link: a node and the interface name
+// inside that node's netns.
+type Endpoint struct {
+	Node   string
+	IfName string
+}
+
+// LinkConfig describes a single link between two nodes, as declared under
+// `links:` in the topology YAML.
+type LinkConfig struct {
+	Endpoints [2]Endpoint
+	// Kind selects the links.Driver used to wire this link up, e.g. "veth"
+	// (the default) or "cni". Empty means the default veth driver.
+	Kind string
+	// Plugin/Master are forwarded to the driver as links.AttachOpts for
+	// CNI-backed kinds (e.g. Plugin: "macvlan", Master: "eth1").
+	Plugin string
+	Master string
+}
@@ -0,0 +1,20 @@
+package types
+
+// MgmtNet struct defines the management network options
+// it is provided via config file or command line flags
+type MgmtNet struct {
+	Network    string `yaml:"network,omitempty"`
+	IPv4Subnet string `yaml:"ipv4-subnet,omitempty"`
+	IPv6Subnet string `yaml:"ipv6-subnet,omitempty"`
+	// IPStack selects which address families the management network is
+	// brought up with. One of "v4" (default), "v6" or "dual".
+	IPStack string `yaml:"ip-stack,omitempty"`
+}
+
+// IP stack modes accepted by MgmtNet.IPStack.
+const (
+	IPStackV4   = "v4"
+	IPStackV6   = "v6"
+	IPStackDual = "dual"
+)
+
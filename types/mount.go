@@ -0,0 +1,31 @@
+package types
+
+// Mount describes one filesystem mount a node needs, richer than the plain
+// "src:dst" bind strings NodeConfig.Binds historically carried. It is the
+// building block for features (like overlayed flash dirs) that need more
+// than a 1:1 host-path-to-container-path mapping.
+type Mount struct {
+	// Destination is the path inside the container, e.g. "/mnt/flash".
+	Destination string
+	// Source is the host path backing Destination for a plain bind mount,
+	// or the lowerdir for an overlay mount.
+	Source string
+	// Overlay, when non-nil, turns this into an overlayfs mount instead of
+	// a plain bind: Source is the lowerdir, and upper/work come from Overlay.
+	Overlay *OverlayOptions
+}
+
+// OverlayOptions configures the upper layer of an overlayfs mount.
+type OverlayOptions struct {
+	// UpperDir is the persistent upper layer. Empty means ephemeral
+	// (backed by tmpfs, discarded when the lab is destroyed).
+	UpperDir string
+	// WorkDir is overlayfs's required scratch dir, alongside UpperDir.
+	// Ignored (and auto-generated) when UpperDir is empty.
+	WorkDir string
+}
+
+// Ephemeral reports whether this overlay has no persistent upper layer.
+func (o *OverlayOptions) Ephemeral() bool {
+	return o == nil || o.UpperDir == ""
+}
@@ -0,0 +1,22 @@
+package types
+
+// NetworkAttachment describes one network a node should be connected to,
+// beyond the lab's single default management network. Modelled on Multus's
+// "default network + N additional networks" so a node can carry separate
+// OOB/data/telemetry planes.
+type NetworkAttachment struct {
+	// Name is the network to attach to, e.g. "mgmt-oob" or "telemetry".
+	// It must already exist or be creatable by the runtime (see
+	// runtime.NetworkManager).
+	Name string `yaml:"name"`
+	// IPv4/IPv6 are optional static addresses (CIDR form, e.g.
+	// "192.0.2.5/24"); left empty, the runtime assigns one from the
+	// network's IPAM pool.
+	IPv4 string `yaml:"ipv4,omitempty"`
+	IPv6 string `yaml:"ipv6,omitempty"`
+	// MAC is an optional static MAC address for this attachment.
+	MAC string `yaml:"mac,omitempty"`
+	// IfName is the interface name inside the container; left empty, the
+	// runtime's default naming (e.g. eth1, eth2, ...) is used.
+	IfName string `yaml:"ifname,omitempty"`
+}
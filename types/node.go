@@ -0,0 +1,58 @@
+package types
+
+import (
+	"bytes"
+	"os"
+	"text/template"
+)
+
+// NodeConfig holds the deploy-time configuration and runtime-discovered
+// state for a single lab node. NodeBase is an alias kept for call sites
+// that only need the fields populated before a node's container exists.
+type NodeConfig struct {
+	ShortName   string
+	LongName    string
+	Kind        string
+	NetworkMode string
+	MacAddress  string
+	Cmd         string
+	Env         map[string]string
+	Binds       []string
+	LabDir      string
+	ResConfig   string
+	ContainerID string
+	NSPath      string
+
+	MgmtIPv4Address      string
+	MgmtIPv4PrefixLength int
+	MgmtIPv6Address      string
+	MgmtIPv6PrefixLength int
+
+	// Overlay configures the flash/config dir as an overlayfs (persistent
+	// upperdir or ephemeral tmpfs) instead of a plain bind mount. Set from
+	// the node's `overlay:` topology option; nil means a plain bind mount.
+	Overlay *OverlayOptions
+
+	// Networks lists secondary networks this node attaches to, in addition
+	// to the lab's default management network. Populated from the node's
+	// `networks:` topology option.
+	Networks []NetworkAttachment
+}
+
+// NodeBase is an alias for NodeConfig; some call sites only deal with a
+// node before it has been deployed and name the type for that intent.
+type NodeBase = NodeConfig
+
+// GenerateConfig renders tplContent as a text/template using the node's
+// config and writes the result to dst.
+func (n *NodeConfig) GenerateConfig(dst, tplContent string) error {
+	tpl, err := template.New(n.ShortName).Parse(tplContent)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, n); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, buf.Bytes(), 0644)
+}
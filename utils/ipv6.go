@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// dockerDaemonConfig is the path to docker's daemon.json, the only place
+// its ipv6/ip6tables settings can be enabled.
+const dockerDaemonConfig = "/etc/docker/daemon.json"
+
+// sysctlIPv6Forwarding is the kernel knob that must be enabled for Docker
+// to be able to route traffic on an IPv6-only or dual-stack management network.
+const sysctlIPv6Forwarding = "/proc/sys/net/ipv6/conf/all/forwarding"
+
+// CheckIPv6Forwarding verifies that net.ipv6.conf.all.forwarding is enabled
+// on the host, returning an error with remediation steps if it is not.
+func CheckIPv6Forwarding() error {
+	b, err := os.ReadFile(sysctlIPv6Forwarding)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", sysctlIPv6Forwarding, err)
+	}
+	if strings.TrimSpace(string(b)) != "1" {
+		return fmt.Errorf("net.ipv6.conf.all.forwarding is disabled; enable it with " +
+			"'sysctl -w net.ipv6.conf.all.forwarding=1' before deploying an IPv6 lab")
+	}
+	return nil
+}
+
+// DockerIPv6Daemon captures the subset of the docker daemon.json settings
+// that must be enabled for containerlab to create an IPv6-capable management
+// network.
+type DockerIPv6Daemon struct {
+	IPv6      bool `json:"ipv6"`
+	IP6Tables bool `json:"ip6tables"`
+}
+
+// CheckDockerIPv6Daemon reads /etc/docker/daemon.json and verifies the
+// daemon has been configured with "ipv6": true and "ip6tables": true,
+// which containerlab cannot set on the user's behalf.
+func CheckDockerIPv6Daemon() error {
+	b, err := os.ReadFile(dockerDaemonConfig)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v; docker must have \"ipv6\": true and "+
+			"\"ip6tables\": true configured for an IPv6 or dual-stack lab", dockerDaemonConfig, err)
+	}
+	var cfg DockerIPv6Daemon
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", dockerDaemonConfig, err)
+	}
+	if !cfg.IPv6 {
+		return fmt.Errorf(`docker daemon has ipv6 support disabled; add "ipv6": true to %s`, dockerDaemonConfig)
+	}
+	if !cfg.IP6Tables {
+		return fmt.Errorf(`docker daemon has ip6tables support disabled; add "ip6tables": true to %s`, dockerDaemonConfig)
+	}
+	return nil
+}
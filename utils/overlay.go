@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"fmt"
+	"path/filepath"
+	"syscall"
+
+	"github.com/srl-labs/containerlab/types"
+)
+
+// MountOverlayBind prepares m as an overlayfs mount and returns the bind
+// string ("<merged>:<dest>") to append to a node's Binds, so node kinds
+// that want a layered filesystem (cEOS flash, SR Linux/XRd config dirs)
+// don't have to hand-roll overlayfs setup themselves.
+//
+// A persistent OverlayOptions.UpperDir survives across
+// `containerlab deploy --reconfigure`; an ephemeral one gets a fresh tmpfs
+// upper every deploy, so changes never outlive the lab.
+func MountOverlayBind(labDir string, m types.Mount) (string, error) {
+	if m.Overlay == nil {
+		return fmt.Sprintf("%s:%s", m.Source, m.Destination), nil
+	}
+
+	overlayDir := filepath.Join(labDir, "overlay", filepath.Base(m.Destination))
+	mergedDir := filepath.Join(overlayDir, "merged")
+	workDir := filepath.Join(overlayDir, "work")
+	upperDir := filepath.Join(overlayDir, "upper")
+	CreateDirectory(mergedDir, 0755)
+	CreateDirectory(workDir, 0755)
+
+	if m.Overlay.Ephemeral() {
+		// tmpfs upper: mount it on its own sub-path, not on overlayDir
+		// itself, since that's the parent mergedDir also lives under
+		ephemeralDir := filepath.Join(overlayDir, "ephemeral")
+		CreateDirectory(ephemeralDir, 0755)
+		if err := syscall.Mount("tmpfs", ephemeralDir, "tmpfs", 0, ""); err != nil {
+			return "", fmt.Errorf("failed to mount tmpfs upper for %s: %v", m.Destination, err)
+		}
+		upperDir = filepath.Join(ephemeralDir, "upper")
+		workDir = filepath.Join(ephemeralDir, "work")
+		CreateDirectory(upperDir, 0755)
+		CreateDirectory(workDir, 0755)
+	} else {
+		upperDir = m.Overlay.UpperDir
+		CreateDirectory(upperDir, 0755)
+		workDir = m.Overlay.WorkDir
+		if workDir == "" {
+			workDir = defaultOverlayWorkDir(upperDir)
+		}
+		CreateDirectory(workDir, 0755)
+	}
+
+	opts := fmt.Sprintf("lowerdir=%s,upperdir=%s,workdir=%s", m.Source, upperDir, workDir)
+	if err := syscall.Mount("overlay", mergedDir, "overlay", 0, opts); err != nil {
+		return "", fmt.Errorf("failed to mount overlay for %s: %v", m.Destination, err)
+	}
+
+	return fmt.Sprintf("%s:%s", mergedDir, m.Destination), nil
+}
+
+// defaultOverlayWorkDir picks a workdir for upperDir when the topology
+// doesn't set one explicitly. overlayfs requires upperdir and workdir on
+// the same filesystem, so it must be a sibling of upperDir rather than
+// anywhere under labDir.
+func defaultOverlayWorkDir(upperDir string) string {
+	return filepath.Join(filepath.Dir(upperDir), "."+filepath.Base(upperDir)+"-work")
+}
@@ -0,0 +1,18 @@
+package utils
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestDefaultOverlayWorkDir(t *testing.T) {
+	upperDir := "/mnt/data/ceos1/upper"
+	got := defaultOverlayWorkDir(upperDir)
+
+	if filepath.Dir(got) != filepath.Dir(upperDir) {
+		t.Fatalf("defaultOverlayWorkDir(%q) = %q, not a sibling of upperDir", upperDir, got)
+	}
+	if got == upperDir {
+		t.Fatalf("defaultOverlayWorkDir(%q) returned upperDir itself", upperDir)
+	}
+}